@@ -1,50 +1,16 @@
 package cloudops
 
-import (
-	"errors"
-	"fmt"
-)
-
 const (
 	// SetIdentifierNone is a default identifier to group all disks from a
 	// particular set
 	SetIdentifierNone = "None"
+	// NodeMaxBlockVolumesEnvVar overrides a driver's computed
+	// MaxVolumeAttachments for the current node, e.g. for hypervisors
+	// such as CloudStack or VMware where the ceiling is not fixed by
+	// instance type alone.
+	NodeMaxBlockVolumesEnvVar = "NODE_MAX_BLOCK_VOLUMES"
 )
 
-// Custom storage operation error codes.
-const (
-	_ = iota + 5000
-	// ErrVolDetached is code for a volume is detached on the instance
-	ErrVolDetached
-	// ErrVolInval is the code for a invalid volume
-	ErrVolInval
-	// ErrVolAttachedOnRemoteNode is code when a volume is not attached locally
-	// but attached on a remote node
-	ErrVolAttachedOnRemoteNode
-	// ErrVolNotFound is code when a volume is not found
-	ErrVolNotFound
-	// ErrInvalidDevicePath is code when a volume/disk has invalid device path
-	ErrInvalidDevicePath
-)
-
-var (
-	// ErrUnsupported operation is unsupported.
-	ErrUnsupported = errors.New("Unsupported Operation")
-)
-
-// ErrNotSupported is returned when a particular operation is not supported
-var ErrNotSupported = fmt.Errorf("operation not supported")
-
-// StorageError error returned for storage operations
-type StorageError struct {
-	// Code is one of storage operation driver error codes.
-	Code int
-	// Msg is human understandable error message.
-	Msg string
-	// Instance provides more information on the error.
-	Instance string
-}
-
 // CloudResourceInfo provides metadata information on a cloud resource.
 type CloudResourceInfo struct {
 	// Name of the cloud resource.
@@ -87,14 +53,29 @@ type Compute interface {
 	// InspectInstanceGroupForInstance inspects the instance group to which the
 	// cloud instance with given ID belongs
 	InspectInstanceGroupForInstance(instanceID string) (*InstanceGroupInfo, error)
+	// MaxVolumeAttachments returns the maximum number of volumes that can
+	// be attached to the instance with the given ID, as dictated by its
+	// instance type/hypervisor (e.g. 28 on Nitro, 16 on Xen). This can be
+	// overridden via the NODE_MAX_BLOCK_VOLUMES env var or a driver option.
+	MaxVolumeAttachments(instanceID string) (int, error)
+	// CurrentVolumeAttachments returns the number of volumes currently
+	// attached to the instance with the given ID.
+	CurrentVolumeAttachments(instanceID string) (int, error)
 }
 
 // Storage interface to manage storage operations.
 type Storage interface {
 	// Create volume based on input template volume and also apply given labels.
-	Create(template interface{}, labels map[string]string) (interface{}, error)
+	Create(template VolumeTemplate, labels map[string]string) (interface{}, error)
 	// GetDeviceID returns ID/Name of the given device/disk or snapshot
 	GetDeviceID(template interface{}) (string, error)
+	// ExpandVolume resizes volumeID to newSizeGiB, optionally changing its
+	// provisioned IOPS and/or throughput in the same call. A nil
+	// newIOPS/newThroughput leaves that property unchanged.
+	ExpandVolume(volumeID string, newSizeGiB int64, newIOPS, newThroughput *int64) error
+	// ModifyVolume applies the given modifications, such as a volume type
+	// or IOPS/throughput change, to volumeID.
+	ModifyVolume(volumeID string, mods VolumeModification) error
 	// Attach volumeID.
 	// Return attach path.
 	Attach(volumeID string) (string, error)
@@ -129,6 +110,17 @@ type Storage interface {
 	Snapshot(volumeID string, readonly bool) (interface{}, error)
 	// SnapshotDelete deletes the snapshot with given ID
 	SnapshotDelete(snapID string) error
+	// ListSnapshots returns the snapshots matching filter.
+	ListSnapshots(filter SnapshotFilter) ([]SnapshotInfo, error)
+	// InspectSnapshot returns detailed information on the snapshot with
+	// the given ID.
+	InspectSnapshot(id string) (*SnapshotInfo, error)
+	// CopySnapshot copies the snapshot with the given ID to destRegion and
+	// returns the ID of the new snapshot.
+	CopySnapshot(id, destRegion string, opts CopySnapshotOptions) (string, error)
+	// CreateVolumeFromSnapshot creates a new volume from the given
+	// snapshot, applying template on top of the snapshot's properties.
+	CreateVolumeFromSnapshot(snapID string, template VolumeTemplate) (string, error)
 	// ApplyTags will apply given labels/tags on the given volume
 	ApplyTags(volumeID string, labels map[string]string) error
 	// RemoveTags removes labels/tags from the given volume
@@ -146,12 +138,3 @@ type Ops interface {
 	// Compute operations in the cloud
 	Compute
 }
-
-// NewStorageError creates a new custom storage error instance
-func NewStorageError(code int, msg string, instance string) error {
-	return &StorageError{Code: code, Msg: msg, Instance: instance}
-}
-
-func (e *StorageError) Error() string {
-	return e.Msg
-}
@@ -0,0 +1,311 @@
+package cloudops
+
+import "context"
+
+// ComputeCtx is Compute's context-aware counterpart. Every method takes a
+// context.Context as its first parameter so callers can apply
+// cancellation, deadlines, and request-scoped tracing (e.g. OpenTelemetry
+// spans) to cloud API calls.
+type ComputeCtx interface {
+	// InstanceIDCtx of instance where command is executed.
+	InstanceIDCtx(ctx context.Context) string
+	// InspectInstanceCtx inspects the node with the given instance ID.
+	InspectInstanceCtx(ctx context.Context, instanceID string) (*InstanceInfo, error)
+	// InspectInstanceGroupForInstanceCtx inspects the instance group to
+	// which the cloud instance with given ID belongs.
+	InspectInstanceGroupForInstanceCtx(ctx context.Context, instanceID string) (*InstanceGroupInfo, error)
+	// MaxVolumeAttachmentsCtx returns the maximum number of volumes that
+	// can be attached to the instance with the given ID.
+	MaxVolumeAttachmentsCtx(ctx context.Context, instanceID string) (int, error)
+	// CurrentVolumeAttachmentsCtx returns the number of volumes currently
+	// attached to the instance with the given ID.
+	CurrentVolumeAttachmentsCtx(ctx context.Context, instanceID string) (int, error)
+}
+
+// StorageCtx is Storage's context-aware counterpart. Every method takes a
+// context.Context as its first parameter so callers can apply
+// cancellation, deadlines, and request-scoped tracing (e.g. OpenTelemetry
+// spans) to cloud API calls.
+type StorageCtx interface {
+	// CreateCtx volume based on input template volume and also apply
+	// given labels.
+	CreateCtx(ctx context.Context, template VolumeTemplate, labels map[string]string) (interface{}, error)
+	// GetDeviceIDCtx returns ID/Name of the given device/disk or snapshot.
+	GetDeviceIDCtx(ctx context.Context, template interface{}) (string, error)
+	// ExpandVolumeCtx resizes volumeID to newSizeGiB, optionally changing
+	// its provisioned IOPS and/or throughput in the same call.
+	ExpandVolumeCtx(ctx context.Context, volumeID string, newSizeGiB int64, newIOPS, newThroughput *int64) error
+	// ModifyVolumeCtx applies the given modifications to volumeID.
+	ModifyVolumeCtx(ctx context.Context, volumeID string, mods VolumeModification) error
+	// AttachCtx volumeID. Return attach path.
+	AttachCtx(ctx context.Context, volumeID string) (string, error)
+	// DetachCtx volumeID.
+	DetachCtx(ctx context.Context, volumeID string) error
+	// DetachFromCtx detaches the disk/volume with given ID from the given
+	// instance ID.
+	DetachFromCtx(ctx context.Context, volumeID, instanceID string) error
+	// DeleteCtx volumeID.
+	DeleteCtx(ctx context.Context, volumeID string) error
+	// DeleteFromCtx deletes the given volume/disk from the given
+	// instanceID.
+	DeleteFromCtx(ctx context.Context, volumeID, instanceID string) error
+	// DescribeCtx an instance.
+	DescribeCtx(ctx context.Context) (interface{}, error)
+	// FreeDevicesCtx returns free block devices on the instance.
+	FreeDevicesCtx(ctx context.Context, blockDeviceMappings []interface{}, rootDeviceName string) ([]string, error)
+	// InspectCtx volumes specified by volumeID.
+	InspectCtx(ctx context.Context, volumeIds []*string) ([]interface{}, error)
+	// DeviceMappingsCtx returns map[local_attached_volume_path]->volume ID/NAME.
+	DeviceMappingsCtx(ctx context.Context) (map[string]string, error)
+	// EnumerateCtx volumes that match given filters, organized into sets
+	// identified by setIdentifier.
+	EnumerateCtx(ctx context.Context, volumeIds []*string, labels map[string]string, setIdentifier string) (map[string][]interface{}, error)
+	// DevicePathCtx for the given volume i.e path where it's attached.
+	DevicePathCtx(ctx context.Context, volumeID string) (string, error)
+	// SnapshotCtx the volume with given volumeID.
+	SnapshotCtx(ctx context.Context, volumeID string, readonly bool) (interface{}, error)
+	// SnapshotDeleteCtx deletes the snapshot with given ID.
+	SnapshotDeleteCtx(ctx context.Context, snapID string) error
+	// ListSnapshotsCtx returns the snapshots matching filter.
+	ListSnapshotsCtx(ctx context.Context, filter SnapshotFilter) ([]SnapshotInfo, error)
+	// InspectSnapshotCtx returns detailed information on the snapshot with
+	// the given ID.
+	InspectSnapshotCtx(ctx context.Context, id string) (*SnapshotInfo, error)
+	// CopySnapshotCtx copies the snapshot with the given ID to destRegion
+	// and returns the ID of the new snapshot.
+	CopySnapshotCtx(ctx context.Context, id, destRegion string, opts CopySnapshotOptions) (string, error)
+	// CreateVolumeFromSnapshotCtx creates a new volume from the given
+	// snapshot, applying template on top of the snapshot's properties.
+	CreateVolumeFromSnapshotCtx(ctx context.Context, snapID string, template VolumeTemplate) (string, error)
+	// ApplyTagsCtx will apply given labels/tags on the given volume.
+	ApplyTagsCtx(ctx context.Context, volumeID string, labels map[string]string) error
+	// RemoveTagsCtx removes labels/tags from the given volume.
+	RemoveTagsCtx(ctx context.Context, volumeID string, labels map[string]string) error
+	// TagsCtx will list the existing labels/tags on the given volume.
+	TagsCtx(ctx context.Context, volumeID string) (map[string]string, error)
+}
+
+// computeCtxAdapter adapts a Compute implementation to ComputeCtx. It only
+// checks ctx for cancellation before delegating; drivers that need true
+// mid-call cancellation should implement ComputeCtx directly.
+// InstanceIDCtx has no error return and ignores ctx.
+type computeCtxAdapter struct {
+	Compute
+}
+
+// NewComputeCtx wraps c so it can be used as a ComputeCtx.
+func NewComputeCtx(c Compute) ComputeCtx {
+	return &computeCtxAdapter{Compute: c}
+}
+
+func (a *computeCtxAdapter) InstanceIDCtx(ctx context.Context) string {
+	return a.Compute.InstanceID()
+}
+
+func (a *computeCtxAdapter) InspectInstanceCtx(ctx context.Context, instanceID string) (*InstanceInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Compute.InspectInstance(instanceID)
+}
+
+func (a *computeCtxAdapter) InspectInstanceGroupForInstanceCtx(ctx context.Context, instanceID string) (*InstanceGroupInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Compute.InspectInstanceGroupForInstance(instanceID)
+}
+
+func (a *computeCtxAdapter) MaxVolumeAttachmentsCtx(ctx context.Context, instanceID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return a.Compute.MaxVolumeAttachments(instanceID)
+}
+
+func (a *computeCtxAdapter) CurrentVolumeAttachmentsCtx(ctx context.Context, instanceID string) (int, error) {
+	if err := ctx.Err(); err != nil {
+		return 0, err
+	}
+	return a.Compute.CurrentVolumeAttachments(instanceID)
+}
+
+// storageCtxAdapter adapts a Storage implementation to StorageCtx. It only
+// checks ctx for cancellation before delegating; drivers that need true
+// mid-call cancellation should implement StorageCtx directly.
+type storageCtxAdapter struct {
+	Storage
+}
+
+// NewStorageCtx wraps s so it can be used as a StorageCtx.
+func NewStorageCtx(s Storage) StorageCtx {
+	return &storageCtxAdapter{Storage: s}
+}
+
+func (a *storageCtxAdapter) CreateCtx(ctx context.Context, template VolumeTemplate, labels map[string]string) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Create(template, labels)
+}
+
+func (a *storageCtxAdapter) GetDeviceIDCtx(ctx context.Context, template interface{}) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.Storage.GetDeviceID(template)
+}
+
+func (a *storageCtxAdapter) ExpandVolumeCtx(ctx context.Context, volumeID string, newSizeGiB int64, newIOPS, newThroughput *int64) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.ExpandVolume(volumeID, newSizeGiB, newIOPS, newThroughput)
+}
+
+func (a *storageCtxAdapter) ModifyVolumeCtx(ctx context.Context, volumeID string, mods VolumeModification) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.ModifyVolume(volumeID, mods)
+}
+
+func (a *storageCtxAdapter) AttachCtx(ctx context.Context, volumeID string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.Storage.Attach(volumeID)
+}
+
+func (a *storageCtxAdapter) DetachCtx(ctx context.Context, volumeID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.Detach(volumeID)
+}
+
+func (a *storageCtxAdapter) DetachFromCtx(ctx context.Context, volumeID, instanceID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.DetachFrom(volumeID, instanceID)
+}
+
+func (a *storageCtxAdapter) DeleteCtx(ctx context.Context, volumeID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.Delete(volumeID)
+}
+
+func (a *storageCtxAdapter) DeleteFromCtx(ctx context.Context, volumeID, instanceID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.DeleteFrom(volumeID, instanceID)
+}
+
+func (a *storageCtxAdapter) DescribeCtx(ctx context.Context) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Describe()
+}
+
+func (a *storageCtxAdapter) FreeDevicesCtx(ctx context.Context, blockDeviceMappings []interface{}, rootDeviceName string) ([]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.FreeDevices(blockDeviceMappings, rootDeviceName)
+}
+
+func (a *storageCtxAdapter) InspectCtx(ctx context.Context, volumeIds []*string) ([]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Inspect(volumeIds)
+}
+
+func (a *storageCtxAdapter) DeviceMappingsCtx(ctx context.Context) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.DeviceMappings()
+}
+
+func (a *storageCtxAdapter) EnumerateCtx(ctx context.Context, volumeIds []*string, labels map[string]string, setIdentifier string) (map[string][]interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Enumerate(volumeIds, labels, setIdentifier)
+}
+
+func (a *storageCtxAdapter) DevicePathCtx(ctx context.Context, volumeID string) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.Storage.DevicePath(volumeID)
+}
+
+func (a *storageCtxAdapter) SnapshotCtx(ctx context.Context, volumeID string, readonly bool) (interface{}, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Snapshot(volumeID, readonly)
+}
+
+func (a *storageCtxAdapter) SnapshotDeleteCtx(ctx context.Context, snapID string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.SnapshotDelete(snapID)
+}
+
+func (a *storageCtxAdapter) ListSnapshotsCtx(ctx context.Context, filter SnapshotFilter) ([]SnapshotInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.ListSnapshots(filter)
+}
+
+func (a *storageCtxAdapter) InspectSnapshotCtx(ctx context.Context, id string) (*SnapshotInfo, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.InspectSnapshot(id)
+}
+
+func (a *storageCtxAdapter) CopySnapshotCtx(ctx context.Context, id, destRegion string, opts CopySnapshotOptions) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.Storage.CopySnapshot(id, destRegion, opts)
+}
+
+func (a *storageCtxAdapter) CreateVolumeFromSnapshotCtx(ctx context.Context, snapID string, template VolumeTemplate) (string, error) {
+	if err := ctx.Err(); err != nil {
+		return "", err
+	}
+	return a.Storage.CreateVolumeFromSnapshot(snapID, template)
+}
+
+func (a *storageCtxAdapter) ApplyTagsCtx(ctx context.Context, volumeID string, labels map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.ApplyTags(volumeID, labels)
+}
+
+func (a *storageCtxAdapter) RemoveTagsCtx(ctx context.Context, volumeID string, labels map[string]string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return a.Storage.RemoveTags(volumeID, labels)
+}
+
+func (a *storageCtxAdapter) TagsCtx(ctx context.Context, volumeID string) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return a.Storage.Tags(volumeID)
+}
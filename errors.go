@@ -0,0 +1,144 @@
+package cloudops
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// Custom storage operation error codes. Drivers construct a *StorageError
+// with one of these via NewStorageError; callers match it with
+// errors.Is(err, <sentinel>) against the corresponding sentinel value
+// below rather than comparing Code directly.
+const (
+	_ = iota + 5000
+	// ErrVolDetached is code for a volume is detached on the instance
+	ErrVolDetached
+	// ErrVolInval is the code for a invalid volume
+	ErrVolInval
+	// ErrVolAttachedOnRemoteNode is code when a volume is not attached locally
+	// but attached on a remote node
+	ErrVolAttachedOnRemoteNode
+	// ErrVolNotFound is code when a volume is not found
+	ErrVolNotFound
+	// ErrInvalidDevicePath is code when a volume/disk has invalid device path
+	ErrInvalidDevicePath
+	// ErrCodeThrottled is code when a cloud API call is rate limited
+	ErrCodeThrottled
+	// ErrCodeQuotaExceeded is code when fulfilling the request would exceed
+	// a cloud account quota
+	ErrCodeQuotaExceeded
+)
+
+var (
+	// ErrUnsupported operation is unsupported.
+	ErrUnsupported = errors.New("Unsupported Operation")
+	// ErrVolumeDetached is the sentinel for ErrVolDetached: an operation
+	// required the volume to be attached but it is detached.
+	ErrVolumeDetached = &StorageError{Code: ErrVolDetached, Msg: "volume is detached"}
+	// ErrVolumeInvalid is the sentinel for ErrVolInval: the volume/template
+	// given to an operation is invalid.
+	ErrVolumeInvalid = &StorageError{Code: ErrVolInval, Msg: "volume is invalid"}
+	// ErrVolumeAttachedElsewhere is the sentinel for
+	// ErrVolAttachedOnRemoteNode: a volume is not attached locally but is
+	// attached on a remote node.
+	ErrVolumeAttachedElsewhere = &StorageError{Code: ErrVolAttachedOnRemoteNode, Msg: "volume is attached on a different node"}
+	// ErrVolumeNotFound is the sentinel for ErrVolNotFound: the volume does
+	// not exist.
+	ErrVolumeNotFound = &StorageError{Code: ErrVolNotFound, Msg: "volume not found"}
+	// ErrDevicePathInvalid is the sentinel for ErrInvalidDevicePath: a
+	// volume/disk resolves to an invalid device path.
+	ErrDevicePathInvalid = &StorageError{Code: ErrInvalidDevicePath, Msg: "invalid device path"}
+	// ErrThrottled is the sentinel for ErrCodeThrottled: the cloud
+	// provider's API has rate limited the request.
+	ErrThrottled = &StorageError{Code: ErrCodeThrottled, Msg: "request throttled by cloud provider"}
+	// ErrQuotaExceeded is the sentinel for ErrCodeQuotaExceeded: fulfilling
+	// the request would exceed a cloud account quota.
+	ErrQuotaExceeded = &StorageError{Code: ErrCodeQuotaExceeded, Msg: "cloud account quota exceeded"}
+)
+
+// ErrNotSupported is returned when a particular operation is not supported
+var ErrNotSupported = fmt.Errorf("operation not supported")
+
+// StorageError error returned for storage operations
+type StorageError struct {
+	// Code is one of storage operation driver error codes.
+	Code int
+	// Msg is human understandable error message.
+	Msg string
+	// Instance provides more information on the error.
+	Instance string
+}
+
+// NewStorageError creates a new custom storage error instance. Drivers
+// should pass one of the exported error codes above (e.g. ErrVolNotFound)
+// so that errors.Is(err, ErrVolumeNotFound) matches for callers.
+func NewStorageError(code int, msg string, instance string) error {
+	return &StorageError{Code: code, Msg: msg, Instance: instance}
+}
+
+func (e *StorageError) Error() string {
+	if e.Instance != "" {
+		return fmt.Sprintf("%s (instance: %s)", e.Msg, e.Instance)
+	}
+	return e.Msg
+}
+
+// Is reports whether target is a *StorageError with the same Code as e, so
+// callers can do errors.Is(err, cloudops.ErrVolumeNotFound) instead of
+// branching on e.Code directly.
+func (e *StorageError) Is(target error) bool {
+	t, ok := target.(*StorageError)
+	if !ok {
+		return false
+	}
+	return e.Code == t.Code
+}
+
+// RetryableError wraps an error to indicate that the caller may retry the
+// operation, e.g. after a transient cloud API failure.
+type RetryableError struct {
+	// Err is the underlying error.
+	Err error
+}
+
+func (e *RetryableError) Error() string {
+	return e.Err.Error()
+}
+
+// Unwrap returns the underlying error so errors.Is/errors.As see through
+// the wrapper.
+func (e *RetryableError) Unwrap() error {
+	return e.Err
+}
+
+// RateLimitError indicates a cloud API call was throttled and carries the
+// backoff duration the provider suggested before retrying.
+type RateLimitError struct {
+	// Err is the underlying throttling error, typically ErrThrottled or
+	// ErrQuotaExceeded.
+	Err error
+	// RetryAfter is the backoff duration suggested by the cloud provider.
+	RetryAfter time.Duration
+}
+
+func (e *RateLimitError) Error() string {
+	return fmt.Sprintf("%s: retry after %s", e.Err.Error(), e.RetryAfter)
+}
+
+// Unwrap returns the underlying error so errors.Is/errors.As see through
+// the wrapper.
+func (e *RateLimitError) Unwrap() error {
+	return e.Err
+}
+
+// IsRetryable returns true if err, or any error it wraps, indicates the
+// caller may retry the operation with exponential backoff.
+func IsRetryable(err error) bool {
+	var re *RetryableError
+	if errors.As(err, &re) {
+		return true
+	}
+	var rle *RateLimitError
+	return errors.As(err, &rle)
+}
@@ -0,0 +1,63 @@
+package cloudops
+
+// BlockDeviceSpec describes a single block device to attach to an instance
+// at launch time.
+type BlockDeviceSpec struct {
+	// NameSuffix is appended to the instance name/ID to derive a name for
+	// the device, e.g. "etcd" or "data".
+	NameSuffix string
+	// SizeGiB is the size of the device in GiB.
+	SizeGiB int64
+	// VolumeType is the storage class for the device.
+	VolumeType VolumeType
+	// IOPS is the requested provisioned IOPS. Ignored by volume types that
+	// do not support provisioned IOPS.
+	IOPS int64
+	// Throughput is the requested throughput in MiB/s. Ignored by volume
+	// types that do not support configurable throughput.
+	Throughput int64
+	// AvailabilityZone is the zone the device should be created in. This
+	// must match the instance's zone.
+	AvailabilityZone string
+	// DeleteOnTermination indicates whether the device should be deleted
+	// when the instance is terminated.
+	DeleteOnTermination bool
+	// Encrypted indicates whether the device should be encrypted at rest.
+	Encrypted bool
+	// Tag is applied to the created device, if the driver supports
+	// tagging devices independently of the instance.
+	Tag map[string]string
+}
+
+// InstanceSpec describes a compute instance to launch, along with its root
+// and additional block devices.
+type InstanceSpec struct {
+	// Name to assign to the instance.
+	Name string
+	// InstanceType is the cloud-specific machine/flavor type to launch,
+	// e.g. an AWS instance type, GCE machine type, or Azure VM size.
+	InstanceType string
+	// ImageID is the ID of the image/AMI/template to boot the instance
+	// from.
+	ImageID string
+	// AvailabilityZone is the zone to launch the instance in.
+	AvailabilityZone string
+	// Labels to apply to the instance.
+	Labels map[string]string
+	// RootBlockDevice describes the instance's root/boot volume.
+	RootBlockDevice BlockDeviceSpec
+	// AdditionalBlockDevices are extra volumes, e.g. for a dedicated
+	// etcd/database/log disk, attached and labeled at boot.
+	AdditionalBlockDevices []BlockDeviceSpec
+}
+
+// ComputeProvisioning manages the lifecycle of compute instances,
+// including their root and additional block devices. Drivers attach and
+// format-label the additional devices at boot and surface them through
+// Storage.DeviceMappings.
+type ComputeProvisioning interface {
+	// LaunchInstance creates a new instance per spec.
+	LaunchInstance(spec InstanceSpec) (*InstanceInfo, error)
+	// TerminateInstance terminates the instance with the given ID.
+	TerminateInstance(instanceID string) error
+}
@@ -0,0 +1,100 @@
+package cloudops
+
+import (
+	"fmt"
+	"sync"
+)
+
+// CredentialSource identifies where a driver should obtain its
+// credentials from.
+type CredentialSource string
+
+const (
+	// CredentialSourceStatic uses the static keys set on Config.Static.
+	CredentialSourceStatic CredentialSource = "static"
+	// CredentialSourceEnv reads credentials from the driver's well-known
+	// environment variables.
+	CredentialSourceEnv CredentialSource = "env"
+	// CredentialSourceSharedConfig reads credentials from the cloud
+	// provider's shared config/credentials file (e.g. ~/.aws/credentials).
+	CredentialSourceSharedConfig CredentialSource = "shared-config"
+	// CredentialSourceInstanceRole reads credentials from the instance
+	// metadata service or attached identity (e.g. an AWS instance
+	// profile, GCE service account, or Azure managed identity).
+	CredentialSourceInstanceRole CredentialSource = "instance-role"
+	// CredentialSourceProvider delegates to Config.CredentialProvider.
+	CredentialSourceProvider CredentialSource = "provider"
+)
+
+// StaticCredentials holds a static access key/secret pair, used with
+// CredentialSourceStatic.
+type StaticCredentials struct {
+	// AccessKeyID is the access key/client ID.
+	AccessKeyID string
+	// SecretAccessKey is the secret key/client secret.
+	SecretAccessKey string
+	// Token is an optional session/security token.
+	Token string
+}
+
+// CredentialProvider is a pluggable source of cloud credentials, e.g.
+// Vault, workload identity federation, or IRSA. It is consulted when
+// Config.CredentialSource is CredentialSourceProvider.
+type CredentialProvider interface {
+	// Name identifies the credential provider, for logging/diagnostics.
+	Name() string
+	// GetCredentials returns the current credentials as provider-specific
+	// key/value pairs (e.g. access key/secret, token).
+	GetCredentials() (map[string]string, error)
+}
+
+// Config carries the parameters needed to construct a driver via New.
+type Config struct {
+	// CredentialSource selects how the driver should obtain credentials.
+	CredentialSource CredentialSource
+	// Static holds static credentials, used when CredentialSource is
+	// CredentialSourceStatic.
+	Static StaticCredentials
+	// SharedConfigFile is the path to the shared config/credentials file,
+	// used when CredentialSource is CredentialSourceSharedConfig. An
+	// empty value lets the driver fall back to its default path.
+	SharedConfigFile string
+	// SharedConfigProfile selects a profile within SharedConfigFile.
+	SharedConfigProfile string
+	// CredentialProvider supplies credentials when CredentialSource is
+	// CredentialSourceProvider.
+	CredentialProvider CredentialProvider
+	// Region is the cloud region the driver should operate in.
+	Region string
+	// Params carries additional driver-specific configuration that has no
+	// portable equivalent above.
+	Params map[string]string
+}
+
+// Factory creates a new Ops driver instance from cfg.
+type Factory func(cfg Config) (Ops, error)
+
+var (
+	driversMu sync.RWMutex
+	drivers   = make(map[string]Factory)
+)
+
+// Register registers a driver factory under name so it can later be
+// constructed by New without the caller importing the driver package
+// directly. It is typically called from a driver package's init().
+func Register(name string, factory Factory) {
+	driversMu.Lock()
+	defer driversMu.Unlock()
+	drivers[name] = factory
+}
+
+// New constructs the driver registered under name using cfg.
+func New(name string, cfg Config) (Ops, error) {
+	driversMu.RLock()
+	factory, ok := drivers[name]
+	driversMu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("cloudops: no driver registered with name %q", name)
+	}
+	return factory(cfg)
+}
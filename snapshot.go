@@ -0,0 +1,64 @@
+package cloudops
+
+import "time"
+
+// SnapshotState represents the lifecycle state of a cloud snapshot.
+type SnapshotState string
+
+const (
+	// SnapshotStatePending means the snapshot is still being created.
+	SnapshotStatePending SnapshotState = "pending"
+	// SnapshotStateReady means the snapshot has completed and is usable.
+	SnapshotStateReady SnapshotState = "ready"
+	// SnapshotStateError means the snapshot failed to complete.
+	SnapshotStateError SnapshotState = "error"
+	// SnapshotStateDeleting means the snapshot is being deleted.
+	SnapshotStateDeleting SnapshotState = "deleting"
+)
+
+// SnapshotInfo describes a point-in-time snapshot of a volume.
+type SnapshotInfo struct {
+	// ID of the snapshot.
+	ID string
+	// SourceVolumeID is the ID of the volume the snapshot was taken from.
+	SourceVolumeID string
+	// SizeGiB is the size of the source volume at snapshot time.
+	SizeGiB int64
+	// State is the current lifecycle state of the snapshot.
+	State SnapshotState
+	// Progress is the completion percentage (0-100) while State is
+	// SnapshotStatePending.
+	Progress int
+	// CreatedAt is when the snapshot was started.
+	CreatedAt time.Time
+	// Encrypted indicates whether the snapshot is encrypted.
+	Encrypted bool
+	// Region where the snapshot resides.
+	Region string
+	// Labels on the snapshot.
+	Labels map[string]string
+}
+
+// SnapshotFilter narrows down the snapshots returned by ListSnapshots. Zero
+// values for a field mean "don't filter on this field".
+type SnapshotFilter struct {
+	// SourceVolumeID restricts results to snapshots of this volume.
+	SourceVolumeID string
+	// Labels restricts results to snapshots carrying all of these labels.
+	Labels map[string]string
+}
+
+// CopySnapshotOptions carries the parameters for a cross-region snapshot
+// copy.
+type CopySnapshotOptions struct {
+	// Name to give the copied snapshot, if the driver supports naming.
+	Name string
+	// Encrypted requests that the copy be encrypted even if the source is
+	// not.
+	Encrypted bool
+	// KMSKeyID to encrypt the copy with. Only used when Encrypted is true;
+	// an empty value lets the driver pick its default key.
+	KMSKeyID string
+	// Labels to apply to the copied snapshot.
+	Labels map[string]string
+}
@@ -0,0 +1,72 @@
+package cloudops
+
+// VolumeType is the class of block storage requested from the cloud
+// provider. The accepted values vary by driver but generally map onto the
+// categories below (e.g. AWS EBS, GCE persistent disks, OpenStack Cinder,
+// Azure managed disks).
+type VolumeType string
+
+const (
+	// VolumeTypeGP2 is general purpose SSD storage (AWS EBS gp2).
+	VolumeTypeGP2 VolumeType = "gp2"
+	// VolumeTypeGP3 is general purpose SSD storage with independently
+	// configurable IOPS/throughput (AWS EBS gp3).
+	VolumeTypeGP3 VolumeType = "gp3"
+	// VolumeTypeIO1 is provisioned IOPS SSD storage (AWS EBS io1).
+	VolumeTypeIO1 VolumeType = "io1"
+	// VolumeTypeIO2 is provisioned IOPS SSD storage with higher durability
+	// (AWS EBS io2).
+	VolumeTypeIO2 VolumeType = "io2"
+	// VolumeTypeStandard is magnetic/HDD backed storage.
+	VolumeTypeStandard VolumeType = "standard"
+	// VolumeTypePDSSD is SSD backed persistent disk storage (GCE pd-ssd).
+	VolumeTypePDSSD VolumeType = "pd-ssd"
+	// VolumeTypePDStandard is HDD backed persistent disk storage
+	// (GCE pd-standard).
+	VolumeTypePDStandard VolumeType = "pd-standard"
+)
+
+// VolumeTemplate describes the properties of a volume to be created. It is
+// the cloud-agnostic intersection of what EBS, GCE persistent disks,
+// Cinder, and Azure managed disks expose, so that provisioning code does
+// not need to branch on the underlying cloud.
+type VolumeTemplate struct {
+	// VolumeType is the storage class/tier for the volume.
+	VolumeType VolumeType
+	// SizeGiB is the requested size of the volume in GiB.
+	SizeGiB int64
+	// IOPS is the requested provisioned IOPS. Ignored by volume types that
+	// do not support provisioned IOPS.
+	IOPS int64
+	// Throughput is the requested throughput in MiB/s. Ignored by volume
+	// types that do not support configurable throughput.
+	Throughput int64
+	// Encrypted indicates whether the volume should be encrypted at rest.
+	Encrypted bool
+	// KMSKeyID is the key to encrypt the volume with. Only used when
+	// Encrypted is true; an empty value lets the driver pick its default
+	// key.
+	KMSKeyID string
+	// AvailabilityZone is the zone the volume should be created in.
+	AvailabilityZone string
+	// SnapshotID, if set, creates the volume from the given snapshot
+	// instead of as a blank volume.
+	SnapshotID string
+	// Labels to apply to the volume at creation time.
+	Labels map[string]string
+	// Extra carries provider-specific parameters that have no portable
+	// equivalent above.
+	Extra map[string]string
+}
+
+// VolumeModification describes an in-place change to an existing volume's
+// type, IOPS, or throughput. A nil field leaves that property unchanged.
+type VolumeModification struct {
+	// NewVolumeType changes the volume's storage class, e.g. upgrading a
+	// gp2 volume to gp3.
+	NewVolumeType *VolumeType
+	// NewIOPS changes the volume's provisioned IOPS.
+	NewIOPS *int64
+	// NewThroughput changes the volume's provisioned throughput in MiB/s.
+	NewThroughput *int64
+}